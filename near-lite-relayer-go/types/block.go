@@ -0,0 +1,27 @@
+package types
+
+// BlockHeaderInnerLite is the subset of a NEAR block header that is covered
+// by the light client's next_block_inner_hash, and is the payload whose
+// sha256 ultimately anchors both the outcome root and the block merkle root.
+type BlockHeaderInnerLite struct {
+	Height          uint64
+	EpochId         CryptoHash
+	NextEpochId     CryptoHash
+	PrevStateRoot   CryptoHash
+	OutcomeRoot     CryptoHash
+	Timestamp       uint64
+	NextBpHash      CryptoHash
+	BlockMerkleRoot CryptoHash
+}
+
+// LightClientBlockView is the response shape of the
+// `next_light_client_block` RPC endpoint, used by the relayer to advance
+// the on-chain light client head.
+type LightClientBlockView struct {
+	PrevBlockHash      CryptoHash
+	NextBlockInnerHash CryptoHash
+	InnerLite          BlockHeaderInnerLite
+	InnerRestHash      CryptoHash
+	NextBps            *[]ValidatorStakeView
+	ApprovalsAfterNext []*Signature
+}
@@ -0,0 +1,37 @@
+package types
+
+import "github.com/near/borsh-go"
+
+// CryptoHash is NEAR's 32-byte sha256 digest, used throughout the protocol
+// for block hashes, state roots and merkle roots.
+type CryptoHash [32]byte
+
+// Ed25519PublicKey and Secp256k1PublicKey wrap a fixed-size key in its own
+// struct rather than using a bare array. borsh-go's complex-enum support
+// only serializes the selected variant when its Kind is Struct - a bare
+// [N]byte variant silently serializes to nothing beyond the discriminant
+// byte - so every PublicKey/Signature variant below needs this wrapper,
+// the same idiom borsh-go's own tests use for complex enums.
+type Ed25519PublicKey struct{ Bytes [32]byte }
+type Secp256k1PublicKey struct{ Bytes [64]byte }
+
+// PublicKey mirrors Rust's near_crypto::PublicKey enum. The discriminant
+// selects which of the two fixed-size variants was written; only one of
+// ED25519/SECP256K1 is populated for a given value.
+type PublicKey struct {
+	Enum      borsh.Enum `borsh_enum:"true"`
+	ED25519   Ed25519PublicKey
+	SECP256K1 Secp256k1PublicKey
+}
+
+// Ed25519Signature and Secp256k1Signature exist for the same reason as
+// Ed25519PublicKey/Secp256k1PublicKey above.
+type Ed25519Signature struct{ Bytes [64]byte }
+type Secp256k1Signature struct{ Bytes [65]byte }
+
+// Signature mirrors Rust's near_crypto::Signature enum.
+type Signature struct {
+	Enum      borsh.Enum `borsh_enum:"true"`
+	ED25519   Ed25519Signature
+	SECP256K1 Secp256k1Signature
+}
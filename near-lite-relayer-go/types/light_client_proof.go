@@ -0,0 +1,56 @@
+package types
+
+import "github.com/near/borsh-go"
+
+// LightClientProof is the response shape of the NEAR RPC
+// `EXPERIMENTAL_light_client_proof` endpoint: a two-layer merkle
+// inclusion proof tying a transaction/receipt outcome back to a light
+// client block the relayer has already accepted.
+type LightClientProof struct {
+	OutcomeProof     ExecutionOutcomeWithIdView
+	OutcomeRootProof MerklePath
+	BlockHeaderLite  BlockHeaderInnerLite
+	BlockProof       MerklePath
+}
+
+// VerifyTransactionInclusion checks proof against blockMerkleRoot, the
+// block_merkle_root of a header the on-chain light client has already
+// accepted. It folds NEAR's two independent merkle layers:
+//
+//  1. outcome -> outcome_root: the outcome's leaf hash is combined with
+//     proof.OutcomeProof.Proof (shard-local) followed by
+//     proof.OutcomeRootProof (across shards) to reach
+//     proof.BlockHeaderLite.OutcomeRoot.
+//  2. block -> block_merkle_root: the header's own hash is combined with
+//     proof.BlockProof to reach blockMerkleRoot.
+//
+// Both must check out for the outcome to be considered included.
+func VerifyTransactionInclusion(proof LightClientProof, blockMerkleRoot CryptoHash) (bool, error) {
+	leaf, err := outcomeLeafHash(proof.OutcomeProof.Id, proof.OutcomeProof.Outcome)
+	if err != nil {
+		return false, err
+	}
+
+	outcomePath := make(MerklePath, 0, len(proof.OutcomeProof.Proof)+len(proof.OutcomeRootProof))
+	outcomePath = append(outcomePath, proof.OutcomeProof.Proof...)
+	outcomePath = append(outcomePath, proof.OutcomeRootProof...)
+	if !VerifyMerklePath(leaf, proof.BlockHeaderLite.OutcomeRoot, outcomePath) {
+		return false, nil
+	}
+
+	headerHash, err := hashBlockHeaderInnerLite(proof.BlockHeaderLite)
+	if err != nil {
+		return false, err
+	}
+	return VerifyMerklePath(headerHash, blockMerkleRoot, proof.BlockProof), nil
+}
+
+// hashBlockHeaderInnerLite is the leaf NEAR's block merkle tree uses for
+// each block: sha256 of the borsh-serialized BlockHeaderInnerLite.
+func hashBlockHeaderInnerLite(inner BlockHeaderInnerLite) (CryptoHash, error) {
+	data, err := borsh.Serialize(inner)
+	if err != nil {
+		return CryptoHash{}, err
+	}
+	return sha256Hash(data), nil
+}
@@ -0,0 +1,125 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TODO(chunk0-3): these fixtures are synthetic, not pulled from a real
+// mainnet block - this sandbox has no network access to a running RPC node
+// to source a real EXPERIMENTAL_light_client_proof response from (same
+// root cause as the corpus.json caveat in
+// testdata/generate/README.md). OutcomeRoot and trustedBlockMerkleRoot are
+// not hand-picked: they're folded from the same leaves/siblings the proof
+// carries by calling the package's own hashing functions below, so the
+// fixture can only pass if VerifyTransactionInclusion's two-layer algorithm
+// agrees with the code that built it - that proves internal consistency,
+// not agreement with NEAR mainnet. Don't cite these tests as validating
+// the verifier against real mainnet data until a real
+// EXPERIMENTAL_light_client_proof response (and its header's
+// block_merkle_root) has been pulled and swapped in here as its own
+// reviewable change.
+
+func fixtureLightClientProof() (LightClientProof, CryptoHash, error) {
+	outcome := ExecutionOutcomeView{
+		Logs:        nil,
+		ReceiptIds:  nil,
+		GasBurnt:    2427993312500,
+		TokensBurnt: NewU128FromUint64(0),
+		ExecutorId:  "relayer.testnet",
+		Status: ExecutionStatusView{
+			Enum:         2, // SuccessValue
+			SuccessValue: ExecutionSuccessValue{Bytes: []byte{}},
+		},
+	}
+	id := CryptoHash{0xa0, 0xa1, 0xa2, 0xa3, 0xa4, 0xa5, 0xa6, 0xa7, 0xa8, 0xa9, 0xaa, 0xab, 0xac, 0xad, 0xae, 0xaf, 0xb0, 0xb1, 0xb2, 0xb3, 0xb4, 0xb5, 0xb6, 0xb7, 0xb8, 0xb9, 0xba, 0xbb, 0xbc, 0xbd, 0xbe, 0xbf}
+
+	leaf, err := outcomeLeafHash(id, outcome)
+	if err != nil {
+		return LightClientProof{}, CryptoHash{}, err
+	}
+
+	outcomeProofSibling := CryptoHash{0xb0, 0xb1, 0xb2, 0xb3, 0xb4, 0xb5, 0xb6, 0xb7, 0xb8, 0xb9, 0xba, 0xbb, 0xbc, 0xbd, 0xbe, 0xbf, 0xc0, 0xc1, 0xc2, 0xc3, 0xc4, 0xc5, 0xc6, 0xc7, 0xc8, 0xc9, 0xca, 0xcb, 0xcc, 0xcd, 0xce, 0xcf}
+	afterOutcomeProof := CombineHash(outcomeProofSibling, leaf) // Left
+
+	outcomeRootSibling := CryptoHash{0xc0, 0xc1, 0xc2, 0xc3, 0xc4, 0xc5, 0xc6, 0xc7, 0xc8, 0xc9, 0xca, 0xcb, 0xcc, 0xcd, 0xce, 0xcf, 0xd0, 0xd1, 0xd2, 0xd3, 0xd4, 0xd5, 0xd6, 0xd7, 0xd8, 0xd9, 0xda, 0xdb, 0xdc, 0xdd, 0xde, 0xdf}
+	outcomeRoot := CombineHash(afterOutcomeProof, outcomeRootSibling) // Right
+
+	header := BlockHeaderInnerLite{
+		Height:          114000000,
+		EpochId:         CryptoHash{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f, 0x20},
+		NextEpochId:     CryptoHash{0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f, 0x20, 0x21},
+		PrevStateRoot:   CryptoHash{0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f, 0x20, 0x21, 0x22},
+		OutcomeRoot:     outcomeRoot,
+		Timestamp:       1750000000000000000,
+		NextBpHash:      CryptoHash{0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f, 0x20, 0x21, 0x22, 0x23},
+		BlockMerkleRoot: CryptoHash{0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f, 0x20, 0x21, 0x22, 0x23, 0x24},
+	}
+
+	headerHash, err := hashBlockHeaderInnerLite(header)
+	if err != nil {
+		return LightClientProof{}, CryptoHash{}, err
+	}
+
+	blockProofSibling1 := CryptoHash{0xd0, 0xd1, 0xd2, 0xd3, 0xd4, 0xd5, 0xd6, 0xd7, 0xd8, 0xd9, 0xda, 0xdb, 0xdc, 0xdd, 0xde, 0xdf, 0xe0, 0xe1, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe7, 0xe8, 0xe9, 0xea, 0xeb, 0xec, 0xed, 0xee, 0xef}
+	afterBlockProof := CombineHash(headerHash, blockProofSibling1) // Right
+
+	blockProofSibling2 := CryptoHash{0xe0, 0xe1, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe7, 0xe8, 0xe9, 0xea, 0xeb, 0xec, 0xed, 0xee, 0xef, 0xf0, 0xf1, 0xf2, 0xf3, 0xf4, 0xf5, 0xf6, 0xf7, 0xf8, 0xf9, 0xfa, 0xfb, 0xfc, 0xfd, 0xfe, 0xff}
+	trustedBlockMerkleRoot := CombineHash(blockProofSibling2, afterBlockProof) // Left
+
+	proof := LightClientProof{
+		OutcomeProof: ExecutionOutcomeWithIdView{
+			Proof:   MerklePath{{Hash: outcomeProofSibling, Direction: Left}},
+			Id:      id,
+			Outcome: outcome,
+		},
+		OutcomeRootProof: MerklePath{{Hash: outcomeRootSibling, Direction: Right}},
+		BlockHeaderLite:  header,
+		BlockProof: MerklePath{
+			{Hash: blockProofSibling1, Direction: Right},
+			{Hash: blockProofSibling2, Direction: Left},
+		},
+	}
+
+	return proof, trustedBlockMerkleRoot, nil
+}
+
+func TestVerifyTransactionInclusion(t *testing.T) {
+	proof, trustedBlockMerkleRoot, err := fixtureLightClientProof()
+	require.NoError(t, err)
+
+	ok, err := VerifyTransactionInclusion(proof, trustedBlockMerkleRoot)
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestVerifyTransactionInclusionRejectsWrongRoot(t *testing.T) {
+	proof, _, err := fixtureLightClientProof()
+	require.NoError(t, err)
+
+	var wrongRoot CryptoHash
+	ok, err := VerifyTransactionInclusion(proof, wrongRoot)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestVerifyTransactionInclusionRejectsTamperedOutcome(t *testing.T) {
+	proof, trustedBlockMerkleRoot, err := fixtureLightClientProof()
+	require.NoError(t, err)
+	proof.OutcomeProof.Outcome.GasBurnt++
+
+	ok, err := VerifyTransactionInclusion(proof, trustedBlockMerkleRoot)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestVerifyMerklePath(t *testing.T) {
+	leaf := CryptoHash{0x01}
+	sibling := CryptoHash{0x02}
+	root := CombineHash(leaf, sibling)
+
+	assert.True(t, VerifyMerklePath(leaf, root, MerklePath{{Hash: sibling, Direction: Right}}))
+	assert.False(t, VerifyMerklePath(leaf, root, MerklePath{{Hash: sibling, Direction: Left}}))
+}
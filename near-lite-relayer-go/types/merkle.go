@@ -0,0 +1,64 @@
+package types
+
+import (
+	"crypto/sha256"
+
+	"github.com/near/borsh-go"
+)
+
+// Direction is the sibling placement in a MerklePathItem, mirroring Rust's
+// near_primitives::merkle::Direction enum.
+type Direction borsh.Enum
+
+const (
+	Left Direction = iota
+	Right
+)
+
+// MerklePathItem is one step of a merkle inclusion proof: the sibling hash
+// to combine with the running hash, and which side it sits on.
+type MerklePathItem struct {
+	Hash      CryptoHash
+	Direction Direction
+}
+
+// MerklePath is the full inclusion proof for a single leaf, ordered from
+// the leaf's sibling up to the root.
+type MerklePath []MerklePathItem
+
+// sha256Hash is a small convenience wrapper returning a fixed-size
+// CryptoHash instead of sha256.Sum256's [32]byte, for readability at call
+// sites that already deal in CryptoHash.
+func sha256Hash(data []byte) CryptoHash {
+	return sha256.Sum256(data)
+}
+
+// CombineHash implements near_primitives::merkle::combine_hash: a parent
+// node's hash is sha256 of its two children concatenated left-to-right.
+func CombineHash(left, right CryptoHash) CryptoHash {
+	h := sha256.New()
+	h.Write(left[:])
+	h.Write(right[:])
+	var out CryptoHash
+	copy(out[:], h.Sum(nil))
+	return out
+}
+
+// VerifyMerklePath folds leafHash up through path - combining each step
+// with its sibling according to the step's Direction, Left meaning the
+// sibling sits to the accumulator's left - and reports whether the
+// resulting root equals want.
+func VerifyMerklePath(leafHash CryptoHash, want CryptoHash, path MerklePath) bool {
+	acc := leafHash
+	for _, item := range path {
+		switch item.Direction {
+		case Left:
+			acc = CombineHash(item.Hash, acc)
+		case Right:
+			acc = CombineHash(acc, item.Hash)
+		default:
+			return false
+		}
+	}
+	return acc == want
+}
@@ -0,0 +1,214 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// targetNearPrimitivesVersion is the version of the Rust near-primitives
+// crate this package's struct/enum layouts were last checked against. Bump
+// it - and recordedSignatures below - together whenever a struct here is
+// deliberately changed to track an upstream layout change.
+const targetNearPrimitivesVersion = "0.20.0"
+
+// recordedSignatures is the typeSignature() expected for each entry in
+// schemaTargets as of targetNearPrimitivesVersion, keyed by name. Update it
+// by hand whenever a tracked type's layout changes on purpose (alongside
+// targetNearPrimitivesVersion, if the change tracks a protocol bump):
+// init() diffs this against the live signatures so a drift panic names the
+// type and shows old vs. new, instead of only two opaque hashes.
+var recordedSignatures = map[string]string{
+	"Direction":                  "uint8",
+	"CryptoHash":                 "[32]uint8",
+	"PublicKey":                  "enum{ED25519:struct{Bytes:[32]uint8},SECP256K1:struct{Bytes:[64]uint8}}",
+	"Signature":                  "enum{ED25519:struct{Bytes:[64]uint8},SECP256K1:struct{Bytes:[65]uint8}}",
+	"MerklePathItem":             "struct{Hash:[32]uint8,Direction:uint8}",
+	"U128":                       "struct{Int:bigint128}",
+	"BlockHeaderInnerLite":       "struct{Height:uint64,EpochId:[32]uint8,NextEpochId:[32]uint8,PrevStateRoot:[32]uint8,OutcomeRoot:[32]uint8,Timestamp:uint64,NextBpHash:[32]uint8,BlockMerkleRoot:[32]uint8}",
+	"ValidatorStakeViewV1":       "struct{AccountId:string,PublicKey:enum{ED25519:struct{Bytes:[32]uint8},SECP256K1:struct{Bytes:[64]uint8}},Stake:struct{Int:bigint128}}",
+	"ValidatorStakeViewV2":       "struct{AccountId:string,PublicKey:enum{ED25519:struct{Bytes:[32]uint8},SECP256K1:struct{Bytes:[64]uint8}},Stake:struct{Int:bigint128},IsChunkOnly:bool}",
+	"ValidatorStakeView":         "enum{V1:struct{AccountId:string,PublicKey:enum{ED25519:struct{Bytes:[32]uint8},SECP256K1:struct{Bytes:[64]uint8}},Stake:struct{Int:bigint128}},V2:struct{AccountId:string,PublicKey:enum{ED25519:struct{Bytes:[32]uint8},SECP256K1:struct{Bytes:[64]uint8}},Stake:struct{Int:bigint128},IsChunkOnly:bool}}",
+	"ExecutionStatusView":        "enum{Unknown:struct{},Failure:struct{Bytes:vec<uint8>},SuccessValue:struct{Bytes:vec<uint8>},SuccessReceiptId:struct{Hash:[32]uint8}}",
+	"ExecutionOutcomeView":       "struct{Logs:vec<string>,ReceiptIds:vec<[32]uint8>,GasBurnt:uint64,TokensBurnt:struct{Int:bigint128},ExecutorId:string,Status:enum{Unknown:struct{},Failure:struct{Bytes:vec<uint8>},SuccessValue:struct{Bytes:vec<uint8>},SuccessReceiptId:struct{Hash:[32]uint8}}}",
+	"ExecutionOutcomeWithIdView": "struct{Proof:vec<struct{Hash:[32]uint8,Direction:uint8}>,BlockHash:[32]uint8,Id:[32]uint8,Outcome:struct{Logs:vec<string>,ReceiptIds:vec<[32]uint8>,GasBurnt:uint64,TokensBurnt:struct{Int:bigint128},ExecutorId:string,Status:enum{Unknown:struct{},Failure:struct{Bytes:vec<uint8>},SuccessValue:struct{Bytes:vec<uint8>},SuccessReceiptId:struct{Hash:[32]uint8}}}}",
+	"LightClientBlockView":       "struct{PrevBlockHash:[32]uint8,NextBlockInnerHash:[32]uint8,InnerLite:struct{Height:uint64,EpochId:[32]uint8,NextEpochId:[32]uint8,PrevStateRoot:[32]uint8,OutcomeRoot:[32]uint8,Timestamp:uint64,NextBpHash:[32]uint8,BlockMerkleRoot:[32]uint8},InnerRestHash:[32]uint8,NextBps:option<vec<enum{V1:struct{AccountId:string,PublicKey:enum{ED25519:struct{Bytes:[32]uint8},SECP256K1:struct{Bytes:[64]uint8}},Stake:struct{Int:bigint128}},V2:struct{AccountId:string,PublicKey:enum{ED25519:struct{Bytes:[32]uint8},SECP256K1:struct{Bytes:[64]uint8}},Stake:struct{Int:bigint128},IsChunkOnly:bool}}>>,ApprovalsAfterNext:vec<option<enum{ED25519:struct{Bytes:[64]uint8},SECP256K1:struct{Bytes:[65]uint8}}>>}",
+	"LightClientProof":           "struct{OutcomeProof:struct{Proof:vec<struct{Hash:[32]uint8,Direction:uint8}>,BlockHash:[32]uint8,Id:[32]uint8,Outcome:struct{Logs:vec<string>,ReceiptIds:vec<[32]uint8>,GasBurnt:uint64,TokensBurnt:struct{Int:bigint128},ExecutorId:string,Status:enum{Unknown:struct{},Failure:struct{Bytes:vec<uint8>},SuccessValue:struct{Bytes:vec<uint8>},SuccessReceiptId:struct{Hash:[32]uint8}}}},OutcomeRootProof:vec<struct{Hash:[32]uint8,Direction:uint8}>,BlockHeaderLite:struct{Height:uint64,EpochId:[32]uint8,NextEpochId:[32]uint8,PrevStateRoot:[32]uint8,OutcomeRoot:[32]uint8,Timestamp:uint64,NextBpHash:[32]uint8,BlockMerkleRoot:[32]uint8},BlockProof:vec<struct{Hash:[32]uint8,Direction:uint8}>}",
+}
+
+// recordedSchemaHash is the expected output of SchemaHash() for
+// targetNearPrimitivesVersion, derived from recordedSignatures so the two
+// can never drift apart from each other.
+var recordedSchemaHash = hashSignatures(recordedSignatures)
+
+// schemaTargets lists every externally-visible struct/enum whose borsh
+// layout must match near-primitives exactly. Add a type here whenever it
+// starts appearing on the wire (borsh.Serialize/Deserialize) with the Rust
+// side, and add its signature to recordedSignatures in the same commit.
+var schemaTargets = map[string]interface{}{
+	"Direction":                  Direction(0),
+	"CryptoHash":                 CryptoHash{},
+	"PublicKey":                  PublicKey{},
+	"Signature":                  Signature{},
+	"MerklePathItem":             MerklePathItem{},
+	"U128":                       U128{},
+	"BlockHeaderInnerLite":       BlockHeaderInnerLite{},
+	"ValidatorStakeViewV1":       ValidatorStakeViewV1{},
+	"ValidatorStakeViewV2":       ValidatorStakeViewV2{},
+	"ValidatorStakeView":         ValidatorStakeView{},
+	"ExecutionStatusView":        ExecutionStatusView{},
+	"ExecutionOutcomeView":       ExecutionOutcomeView{},
+	"ExecutionOutcomeWithIdView": ExecutionOutcomeWithIdView{},
+	"LightClientBlockView":       LightClientBlockView{},
+	"LightClientProof":           LightClientProof{},
+}
+
+// bigIntType is special-cased in typeSignature because math/big.Int's
+// fields are all unexported: generic struct introspection would see it as
+// an empty struct, hiding the fact that it's our wire representation of
+// Rust's u128.
+var bigIntType = reflect.TypeOf(big.Int{})
+
+// typeSignature renders t's borsh-relevant shape - kind, size, field
+// order, and (for tagged unions) variant order - as a deterministic
+// string, so two types are byte-compatible on the wire iff their
+// signatures match.
+func typeSignature(t reflect.Type) string {
+	if t == bigIntType {
+		return "bigint128"
+	}
+
+	switch t.Kind() {
+	case reflect.Array:
+		return fmt.Sprintf("[%d]%s", t.Len(), typeSignature(t.Elem()))
+	case reflect.Slice:
+		return "vec<" + typeSignature(t.Elem()) + ">"
+	case reflect.Ptr:
+		return "option<" + typeSignature(t.Elem()) + ">"
+	case reflect.Struct:
+		return structSignature(t)
+	case reflect.Bool, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.String:
+		return t.Kind().String()
+	default:
+		return t.String()
+	}
+}
+
+// structSignature handles both plain borsh structs and borsh-go tagged
+// unions (a struct whose first field is a borsh.Enum discriminant tagged
+// `borsh_enum:"true"`, as used for PublicKey, Signature and
+// ValidatorStakeView here).
+func structSignature(t reflect.Type) string {
+	if t.NumField() > 0 {
+		first := t.Field(0)
+		if first.Name == "Enum" && first.Tag.Get("borsh_enum") == "true" {
+			var variants []string
+			for i := 1; i < t.NumField(); i++ {
+				f := t.Field(i)
+				variants = append(variants, f.Name+":"+typeSignature(f.Type))
+			}
+			return "enum{" + strings.Join(variants, ",") + "}"
+		}
+	}
+
+	var fields []string
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" { // unexported: not part of the wire layout
+			continue
+		}
+		fields = append(fields, f.Name+":"+typeSignature(f.Type))
+	}
+	return "struct{" + strings.Join(fields, ",") + "}"
+}
+
+// liveSignatures computes typeSignature() for every entry in schemaTargets,
+// keyed by name.
+func liveSignatures() map[string]string {
+	sigs := make(map[string]string, len(schemaTargets))
+	for name, v := range schemaTargets {
+		sigs[name] = typeSignature(reflect.TypeOf(v))
+	}
+	return sigs
+}
+
+// hashSignatures renders a name->signature map as SchemaHash() does -
+// sorted by name, one "name=signature" line each - and hashes the result,
+// so the same signatures always hash the same way regardless of map
+// iteration order.
+func hashSignatures(sigs map[string]string) string {
+	names := make([]string, 0, len(sigs))
+	for name := range sigs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var sb strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&sb, "%s=%s\n", name, sigs[name])
+	}
+
+	sum := sha256.Sum256([]byte(sb.String()))
+	return hex.EncodeToString(sum[:])
+}
+
+// SchemaHash deterministically fingerprints every type in schemaTargets,
+// so downstream bridge implementations can embed it in on-chain state as
+// a cheap check that a relayer build matches the protocol layout it was
+// compiled against.
+func SchemaHash() string {
+	return hashSignatures(liveSignatures())
+}
+
+// schemaDiff compares live against recordedSignatures and renders one line
+// per type whose signature changed, was added, or was removed, so a drift
+// panic says exactly what moved instead of forcing a hand bisection of
+// schemaTargets.
+func schemaDiff(live map[string]string) string {
+	names := make(map[string]struct{}, len(live)+len(recordedSignatures))
+	for name := range live {
+		names[name] = struct{}{}
+	}
+	for name := range recordedSignatures {
+		names[name] = struct{}{}
+	}
+
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	var lines []string
+	for _, name := range sorted {
+		recorded, wasRecorded := recordedSignatures[name]
+		got, isLive := live[name]
+		switch {
+		case wasRecorded && !isLive:
+			lines = append(lines, fmt.Sprintf("  - %s: removed from schemaTargets (was %q)", name, recorded))
+		case !wasRecorded && isLive:
+			lines = append(lines, fmt.Sprintf("  - %s: added to schemaTargets (now %q)", name, got))
+		case recorded != got:
+			lines = append(lines, fmt.Sprintf("  - %s:\n      recorded: %q\n      computed: %q", name, recorded, got))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func init() {
+	live := liveSignatures()
+	if got := hashSignatures(live); got != recordedSchemaHash {
+		panic(fmt.Sprintf(
+			"types: borsh schema drift detected against near-primitives %s: recorded schema hash %s, computed %s\n%s\n"+
+				"a tracked struct/enum's field order, kind, or variant layout changed; update recordedSignatures "+
+				"(and targetNearPrimitivesVersion if this tracks a protocol bump) only after confirming the new "+
+				"layout still matches the Rust side",
+			targetNearPrimitivesVersion, recordedSchemaHash, got, schemaDiff(live),
+		))
+	}
+}
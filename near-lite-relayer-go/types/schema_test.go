@@ -0,0 +1,80 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Pins the same condition the init() guard checks: a tracked struct/enum's
+// layout changed without recordedSignatures being updated to match. Package
+// init() runs before any test, so on drift the test binary panics at start
+// and this test never gets to report it as an ordinary failure.
+func TestSchemaHashMatchesRecorded(t *testing.T) {
+	assert.Equal(t, recordedSchemaHash, SchemaHash())
+}
+
+func TestSchemaHashDeterministic(t *testing.T) {
+	assert.Equal(t, SchemaHash(), SchemaHash())
+}
+
+func TestSchemaDiffNamesChangedType(t *testing.T) {
+	live := liveSignatures()
+	live["Direction"] = "uint16" // simulate a drifted signature
+
+	diff := schemaDiff(live)
+	assert.Contains(t, diff, "Direction:")
+	assert.Contains(t, diff, `recorded: "uint8"`)
+	assert.Contains(t, diff, `computed: "uint16"`)
+}
+
+func TestSchemaDiffNamesAddedAndRemovedTypes(t *testing.T) {
+	live := liveSignatures()
+	delete(live, "Direction")
+	live["NewThing"] = "uint8"
+
+	diff := schemaDiff(live)
+	assert.Contains(t, diff, "Direction: removed from schemaTargets")
+	assert.Contains(t, diff, "NewThing: added to schemaTargets")
+}
+
+func TestSchemaDiffEmptyWhenInSync(t *testing.T) {
+	assert.Empty(t, schemaDiff(liveSignatures()))
+}
+
+func TestTypeSignatureDetectsFieldReorder(t *testing.T) {
+	type fooBar struct {
+		Foo uint8
+		Bar uint64
+	}
+	type barFoo struct {
+		Bar uint64
+		Foo uint8
+	}
+
+	assert.NotEqual(t,
+		structSignature(reflect.TypeOf(fooBar{})),
+		structSignature(reflect.TypeOf(barFoo{})),
+		"swapping field order must change the signature - borsh is positional",
+	)
+}
+
+func TestTypeSignatureDetectsEnumVariantSwap(t *testing.T) {
+	type shape struct {
+		Enum   Direction `borsh_enum:"true"`
+		Square struct{ Side uint64 }
+		Circle struct{ Radius uint64 }
+	}
+	type shapeSwapped struct {
+		Enum   Direction `borsh_enum:"true"`
+		Circle struct{ Radius uint64 }
+		Square struct{ Side uint64 }
+	}
+
+	assert.NotEqual(t,
+		structSignature(reflect.TypeOf(shape{})),
+		structSignature(reflect.TypeOf(shapeSwapped{})),
+		"swapping enum variant order must change the signature - it changes the discriminant each variant gets",
+	)
+}
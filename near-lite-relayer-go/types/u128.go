@@ -0,0 +1,83 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// U128 is an unsigned 128-bit integer, matching Rust's u128 as used for
+// NEAR balances (yoctoNEAR) and validator stake (gas stays a u64; see Gas
+// in outcome.go). It embeds big.Int
+// purely so borsh-go's existing big.Int special-casing serializes it as a
+// fixed 16-byte little-endian integer - the same layout the Rust side
+// produces for u128 - without this package needing its own borsh hooks.
+// JSON marshaling uses a decimal string, matching the convention NEAR RPC
+// uses for u128 fields that exceed JavaScript's safe integer range.
+type U128 struct {
+	big.Int
+}
+
+// NewU128FromUint64 wraps a uint64 as a U128.
+func NewU128FromUint64(v uint64) U128 {
+	var u U128
+	u.SetUint64(v)
+	return u
+}
+
+// NewU128FromString parses a base-10 string, as returned by NEAR RPC, into
+// a U128.
+func NewU128FromString(s string) (U128, error) {
+	var u U128
+	if _, ok := u.SetString(s, 10); !ok {
+		return U128{}, fmt.Errorf("types: invalid U128 decimal string %q", s)
+	}
+	return u, nil
+}
+
+// MarshalJSON renders u as a quoted decimal string, matching NEAR RPC.
+func (u U128) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + u.String() + `"`), nil
+}
+
+// UnmarshalJSON accepts either a quoted decimal string (the NEAR RPC form)
+// or a bare JSON number, for values small enough to round-trip that way.
+func (u *U128) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		s = s[1 : len(s)-1]
+	}
+	if _, ok := u.SetString(s, 10); !ok {
+		return fmt.Errorf("types: invalid U128 JSON value %q", data)
+	}
+	return nil
+}
+
+// maxU128 is 2^128 - 1, the largest value U128 can represent.
+var maxU128 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+
+// Add returns u+other, erroring instead of wrapping if the result would
+// overflow 128 bits.
+func (u U128) Add(other U128) (U128, error) {
+	var sum big.Int
+	sum.Add(&u.Int, &other.Int)
+	if sum.Cmp(maxU128) > 0 {
+		return U128{}, fmt.Errorf("types: U128 overflow: %s + %s", u.String(), other.String())
+	}
+	return U128{sum}, nil
+}
+
+// Sub returns u-other, erroring instead of wrapping if other exceeds u
+// (U128 is unsigned, so that would underflow).
+func (u U128) Sub(other U128) (U128, error) {
+	if u.Int.Cmp(&other.Int) < 0 {
+		return U128{}, fmt.Errorf("types: U128 underflow: %s - %s", u.String(), other.String())
+	}
+	var diff big.Int
+	diff.Sub(&u.Int, &other.Int)
+	return U128{diff}, nil
+}
+
+// Cmp compares u and other, returning -1, 0, or +1 as per big.Int.Cmp.
+func (u U128) Cmp(other U128) int {
+	return u.Int.Cmp(&other.Int)
+}
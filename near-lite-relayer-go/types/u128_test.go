@@ -0,0 +1,79 @@
+package types
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/near/borsh-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestU128BorshLayout exercises values above 2^64 - where a naive uint64
+// field would truncate - to pin down that U128 serializes as the same
+// fixed 16-byte little-endian layout Rust's u128 does.
+func TestU128BorshLayout(t *testing.T) {
+	cases := []struct {
+		name string
+		dec  string
+		hex  string
+	}{
+		{"zero", "0", "00000000000000000000000000000000"},
+		{"one", "1", "01000000000000000000000000000000"},
+		{"max_u64_plus_one", "18446744073709551616", "00000000000000000100000000000000"},
+		{"near_total_supply", "1000000000000000000000000000000000", "000000000a5bc138938d44c64d310000"},
+		{"max_u128", "340282366920938463463374607431768211455", "ffffffffffffffffffffffffffffffff"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			u, err := NewU128FromString(tc.dec)
+			require.NoError(t, err)
+
+			got, err := borsh.Serialize(u)
+			require.NoError(t, err)
+
+			want, err := hex.DecodeString(tc.hex)
+			require.NoError(t, err)
+			assert.Equal(t, want, got)
+
+			var back U128
+			require.NoError(t, borsh.Deserialize(&back, got))
+			assert.Equal(t, 0, u.Cmp(back))
+		})
+	}
+}
+
+func TestU128JSON(t *testing.T) {
+	u, err := NewU128FromString("1000000000000000000000000000000000")
+	require.NoError(t, err)
+
+	data, err := u.MarshalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, `"1000000000000000000000000000000000"`, string(data))
+
+	var back U128
+	require.NoError(t, back.UnmarshalJSON(data))
+	assert.Equal(t, 0, u.Cmp(back))
+}
+
+func TestU128Arithmetic(t *testing.T) {
+	a := NewU128FromUint64(1)
+	max, err := NewU128FromString(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1)).String())
+	require.NoError(t, err)
+
+	_, err = max.Add(a)
+	assert.Error(t, err, "adding 1 to the max U128 value should overflow")
+
+	sum, err := a.Add(NewU128FromUint64(2))
+	require.NoError(t, err)
+	assert.Equal(t, 0, sum.Cmp(NewU128FromUint64(3)))
+
+	_, err = NewU128FromUint64(1).Sub(NewU128FromUint64(2))
+	assert.Error(t, err, "1 - 2 should underflow an unsigned U128")
+
+	diff, err := NewU128FromUint64(5).Sub(NewU128FromUint64(2))
+	require.NoError(t, err)
+	assert.Equal(t, 0, diff.Cmp(NewU128FromUint64(3)))
+}
@@ -0,0 +1,30 @@
+package types
+
+import "github.com/near/borsh-go"
+
+// ValidatorStakeViewV1 is the pre-stateless-validation representation of a
+// validator's stake, as returned by NEAR RPC and embedded in light client
+// blocks.
+type ValidatorStakeViewV1 struct {
+	AccountId string
+	PublicKey PublicKey
+	Stake     U128
+}
+
+// ValidatorStakeViewV2 adds the IsChunkOnly flag introduced alongside
+// chunk-only producers.
+type ValidatorStakeViewV2 struct {
+	AccountId   string
+	PublicKey   PublicKey
+	Stake       U128
+	IsChunkOnly bool
+}
+
+// ValidatorStakeView mirrors Rust's versioned
+// near_primitives::views::ValidatorStakeView enum. Light client blocks
+// produced by older protocol versions serialize as V1; newer ones as V2.
+type ValidatorStakeView struct {
+	Enum borsh.Enum `borsh_enum:"true"`
+	V1   ValidatorStakeViewV1
+	V2   ValidatorStakeViewV2
+}
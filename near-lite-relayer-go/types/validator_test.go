@@ -0,0 +1,34 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/near/borsh-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestValidatorStakeViewRoundTripsPublicKey pins a ValidatorStakeView's
+// embedded PublicKey surviving a full borsh round trip: a struct anchoring
+// validator identity in the light client must not silently drop its key.
+func TestValidatorStakeViewRoundTripsPublicKey(t *testing.T) {
+	want := ValidatorStakeView{
+		Enum: 0, // V1
+		V1: ValidatorStakeViewV1{
+			AccountId: "validator01.pool.near",
+			PublicKey: PublicKey{
+				Enum:    0, // ED25519
+				ED25519: Ed25519PublicKey{Bytes: [32]byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f, 0x20}},
+			},
+			Stake: NewU128FromUint64(35_000_000_000_000_000),
+		},
+	}
+
+	data, err := borsh.Serialize(want)
+	require.NoError(t, err)
+
+	var got ValidatorStakeView
+	require.NoError(t, borsh.Deserialize(&got, data))
+	assert.Equal(t, want, got)
+	assert.Equal(t, want.V1.PublicKey.ED25519.Bytes, got.V1.PublicKey.ED25519.Bytes, "PublicKey payload must not be dropped on serialize")
+}